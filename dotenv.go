@@ -0,0 +1,169 @@
+package envscan
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var dotEnvVarPattern = regexp.MustCompile(`\$\{(\w+)\}`)
+
+// ReadEnvironmentFromFiles loads the given dotenv-formatted files and uses
+// them as defaults for ReadEnvironment, alongside defaults.
+//
+// Supported dotenv syntax:
+//   - KEY=VALUE lines, with an optional `export ` prefix
+//   - blank lines and lines starting with '#' are ignored
+//   - double-quoted values process \n, \" and \\ escapes
+//   - single-quoted values are taken literally
+//   - unquoted values end at the first " #" (an inline comment)
+//   - "${VAR}" inside a double-quoted or unquoted value expands to VAR's
+//     value among what has been loaded so far (including earlier files) or,
+//     failing that, the process environment
+//
+// Precedence for the final value assigned to a struct field is: process
+// environment, then the field's inline `env-default` tag, then the loaded
+// files (the first file to set a given key wins over later files, matching
+// godotenv), then defaults. File values are passed to ReadEnvironment as
+// defaultEnvData, which is why an inline `env-default` outranks them. This mirrors
+// the 12-factor pattern of mounting secrets as files alongside a regular
+// process environment.
+func ReadEnvironmentFromFiles(v any, defaults map[string]string, paths ...string) error {
+	fileValues, err := loadDotEnvFiles(paths...)
+	if err != nil {
+		return err
+	}
+
+	merged := make(map[string]string, len(defaults)+len(fileValues))
+	for key, value := range defaults {
+		merged[key] = value
+	}
+	for key, value := range fileValues {
+		merged[key] = value
+	}
+
+	return ReadEnvironment(v, merged)
+}
+
+// loadDotEnvFiles parses each dotenv file in paths, in order, into a single
+// merged map. The first file to set a given key wins over later files.
+func loadDotEnvFiles(paths ...string) (map[string]string, error) {
+	loaded := make(map[string]string)
+
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open env file %s: %w", path, err)
+		}
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			key, value, expand, ok := parseDotEnvLine(scanner.Text())
+			if !ok {
+				continue
+			}
+
+			if expand {
+				value = expandDotEnvValue(value, loaded)
+			}
+
+			if _, exists := loaded[key]; !exists {
+				loaded[key] = value
+			}
+		}
+		scanErr := scanner.Err()
+		file.Close()
+		if scanErr != nil {
+			return nil, fmt.Errorf("failed to read env file %s: %w", path, scanErr)
+		}
+	}
+
+	return loaded, nil
+}
+
+// parseDotEnvLine parses a single line of dotenv-formatted content into a
+// key/value pair. expand reports whether "${VAR}" references in value still
+// need to be expanded (true for double-quoted and unquoted values, false for
+// single-quoted ones). ok is false for blank lines, comments, and any line
+// that isn't a KEY=VALUE assignment.
+func parseDotEnvLine(line string) (key, value string, expand, ok bool) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return "", "", false, false
+	}
+
+	trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "export "))
+
+	eqIdx := strings.Index(trimmed, "=")
+	if eqIdx < 0 {
+		return "", "", false, false
+	}
+
+	key = strings.TrimSpace(trimmed[:eqIdx])
+	raw := strings.TrimSpace(trimmed[eqIdx+1:])
+
+	if len(raw) > 0 && raw[0] == '"' {
+		if unquoted, ok := unquoteDotEnvDouble(raw[1:]); ok {
+			return key, unquoted, true, true
+		}
+	}
+
+	if len(raw) > 0 && raw[0] == '\'' {
+		if closeIdx := strings.IndexByte(raw[1:], '\''); closeIdx >= 0 {
+			return key, raw[1 : 1+closeIdx], false, true
+		}
+	}
+
+	if commentIdx := strings.Index(raw, " #"); commentIdx >= 0 {
+		raw = strings.TrimSpace(raw[:commentIdx])
+	}
+
+	return key, raw, true, true
+}
+
+// unquoteDotEnvDouble scans a double-quoted value's contents (the part
+// after the opening quote), processing \n, \" and \\ escapes, and reports
+// whether a closing quote was found.
+func unquoteDotEnvDouble(raw string) (string, bool) {
+	var unquoted strings.Builder
+
+	for i := 0; i < len(raw); i++ {
+		switch c := raw[i]; {
+		case c == '\\' && i+1 < len(raw):
+			switch raw[i+1] {
+			case 'n':
+				unquoted.WriteByte('\n')
+			case '"':
+				unquoted.WriteByte('"')
+			case '\\':
+				unquoted.WriteByte('\\')
+			default:
+				unquoted.WriteByte('\\')
+				unquoted.WriteByte(raw[i+1])
+			}
+			i++
+		case c == '"':
+			return unquoted.String(), true
+		default:
+			unquoted.WriteByte(c)
+		}
+	}
+
+	return "", false
+}
+
+// expandDotEnvValue replaces "${VAR}" references in value, preferring
+// loaded (the values parsed so far) and falling back to the process
+// environment. An unresolved reference expands to an empty string, matching
+// shell behavior for an unset variable.
+func expandDotEnvValue(value string, loaded map[string]string) string {
+	return dotEnvVarPattern.ReplaceAllStringFunc(value, func(match string) string {
+		name := dotEnvVarPattern.FindStringSubmatch(match)[1]
+		if resolved, ok := loaded[name]; ok {
+			return resolved
+		}
+		return os.Getenv(name)
+	})
+}
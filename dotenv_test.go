@@ -0,0 +1,137 @@
+package envscan
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeDotEnvFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write %s, err: %v", path, err)
+	}
+	return path
+}
+
+func TestReadEnvironmentFromFilesQuotingAndEscapes(t *testing.T) {
+	dir := t.TempDir()
+	path := writeDotEnvFile(t, dir, ".env", ""+
+		"# a comment\n"+
+		"\n"+
+		"export APP_NAME=envscan\n"+
+		"APP_GREETING=\"hello\\nworld\"\n"+
+		"APP_RAW='$not-expanded' # trailing text inside single quotes is literal\n"+
+		"APP_PORT=8080 # inline comment\n",
+	)
+
+	type MockConfig struct {
+		Name     string `env:"APP_NAME"`
+		Greeting string `env:"APP_GREETING"`
+		Raw      string `env:"APP_RAW"`
+		Port     int    `env:"APP_PORT"`
+	}
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironmentFromFiles(scanningObj, make(map[string]string), path)
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Name != "envscan" {
+		t.Errorf("expected: envscan, got: %s", scanningObj.Name)
+	}
+
+	if scanningObj.Greeting != "hello\nworld" {
+		t.Errorf("expected: %q, got: %q", "hello\nworld", scanningObj.Greeting)
+	}
+
+	if scanningObj.Raw != "$not-expanded" {
+		t.Errorf("expected: $not-expanded, got: %q", scanningObj.Raw)
+	}
+
+	if scanningObj.Port != 8080 {
+		t.Errorf("expected: 8080, got: %d", scanningObj.Port)
+	}
+}
+
+func TestReadEnvironmentFromFilesExpansionAcrossFiles(t *testing.T) {
+	dir := t.TempDir()
+	basePath := writeDotEnvFile(t, dir, "base.env", "APP_HOST=localhost\nAPP_PORT=5432\n")
+	overridePath := writeDotEnvFile(t, dir, "override.env", "APP_DSN=postgres://${APP_HOST}:${APP_PORT}/app\n")
+
+	type MockConfig struct {
+		DSN string `env:"APP_DSN"`
+	}
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironmentFromFiles(scanningObj, make(map[string]string), basePath, overridePath)
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	expected := "postgres://localhost:5432/app"
+	if scanningObj.DSN != expected {
+		t.Errorf("expected: %s, got: %s", expected, scanningObj.DSN)
+	}
+}
+
+func TestReadEnvironmentFromFilesProcessEnvWins(t *testing.T) {
+	dir := t.TempDir()
+	path := writeDotEnvFile(t, dir, ".env", "APP_NAME=from-file\n")
+
+	t.Setenv("APP_NAME", "from-process-env")
+
+	type MockConfig struct {
+		Name string `env:"APP_NAME"`
+	}
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironmentFromFiles(scanningObj, make(map[string]string), path)
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Name != "from-process-env" {
+		t.Errorf("expected: from-process-env, got: %s", scanningObj.Name)
+	}
+}
+
+func TestReadEnvironmentFromFilesFileWinsOverDefaults(t *testing.T) {
+	dir := t.TempDir()
+	path := writeDotEnvFile(t, dir, ".env", "APP_NAME=from-file\n")
+
+	type MockConfig struct {
+		Name string `env:"APP_NAME"`
+	}
+
+	defaults := map[string]string{
+		"APP_NAME": "from-defaults",
+	}
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironmentFromFiles(scanningObj, defaults, path)
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Name != "from-file" {
+		t.Errorf("expected: from-file, got: %s", scanningObj.Name)
+	}
+}
+
+func TestReadEnvironmentFromFilesMissingFile(t *testing.T) {
+	type MockConfig struct {
+		Name string `env:"APP_NAME"`
+	}
+
+	err := ReadEnvironmentFromFiles(&MockConfig{}, make(map[string]string), filepath.Join(t.TempDir(), "missing.env"))
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -5,17 +5,91 @@ package envscan
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"reflect"
 	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
 )
 
 var (
 	ErrNilPointerDeference = errors.New("nil pointer deference error")
 	ErrVMustBePtr          = errors.New("v must be pointer on struct")
+
+	// ErrInvalidBool, ErrInvalidInt, ErrInvalidUint, ErrInvalidFloat,
+	// ErrInvalidDuration, ErrInvalidTime and ErrInvalidLocation wrap the
+	// underlying strconv/time parse error for their respective kind, so
+	// callers can errors.Is against the kind without matching error text.
+	ErrInvalidBool     = errors.New("invalid bool value")
+	ErrInvalidInt      = errors.New("invalid int value")
+	ErrInvalidUint     = errors.New("invalid uint value")
+	ErrInvalidFloat    = errors.New("invalid float value")
+	ErrInvalidDuration = errors.New("invalid duration value")
+	ErrInvalidTime     = errors.New("invalid time value")
+	ErrInvalidLocation = errors.New("invalid time location value")
+	ErrUnsupportedType = errors.New("unsupported field type")
+
+	// ErrFieldRequired is wrapped by the error returned when a field tagged
+	// `env-required:"true"` has no value from the environment, an inline
+	// `env-default`, or defaultEnvData.
+	ErrFieldRequired = errors.New("required field not set")
 )
 
+// FieldError describes a single field's failure, giving callers structured
+// access to which field and environment variable were involved instead of
+// having to parse an error string. ReadEnvironmentAll joins one *FieldError
+// per failing field into the error it returns.
+type FieldError struct {
+	Field   string // dotted field path, e.g. "DB.Host"
+	EnvName string // the resolved environment variable name(s), if relevant
+	Err     error
+}
+
+func (e *FieldError) Error() string {
+	if e.EnvName == "" {
+		return fmt.Sprintf("field %s: %v", e.Field, e.Err)
+	}
+	return fmt.Sprintf("field %s (%s): %v", e.Field, e.EnvName, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	timeDurationType = reflect.TypeOf(time.Duration(0))
+	timeTimeType     = reflect.TypeOf(time.Time{})
+	timeLocationType = reflect.TypeOf(&time.Location{})
+)
+
+// Setter lets a type take over parsing its own environment variable value,
+// bypassing the built-in kind switch entirely. This is the escape hatch for
+// custom types ReadEnvironment has no built-in knowledge of, e.g. enums,
+// URLs, regex patterns, or byte sizes.
+//
+// SetValue is called with the raw, unparsed environment variable (or
+// default) value. It is typically implemented on a pointer receiver so the
+// receiver can mutate itself:
+//
+//	type LogLevel int
+//
+//	func (l *LogLevel) SetValue(raw string) error {
+//	    switch raw {
+//	    case "debug":
+//	        *l = LogLevelDebug
+//	    case "info":
+//	        *l = LogLevelInfo
+//	    default:
+//	        return fmt.Errorf("unknown log level %q", raw)
+//	    }
+//	    return nil
+//	}
+type Setter interface {
+	SetValue(raw string) error
+}
+
 // ReadEnvironment reads environment variables and populates the fields of a struct.
 //
 // This function uses reflection to inspect struct fields and their 'env' tags,
@@ -24,22 +98,72 @@ var (
 // Parameters:
 //   - v: A pointer to a struct whose fields will be populated. Must not be nil.
 //   - defaultEnvData: A map of default values to use when environment variables are not set.
-//     Keys should match the 'env' tag values.
+//     Keys should match the first name in the 'env' tag.
 //
 // Requirements:
 //   - v must be a non-nil pointer to a struct
-//   - All struct fields must have an 'env' tag specifying the environment variable name
-//   - Environment variables or default values must be set for all fields
+//   - All leaf fields must have an 'env' tag specifying the environment variable name
+//
+// Fields are optional by default: if nothing resolves a value, the field is
+// simply left at its zero value. Tag a field `env-required:"true"` to make
+// ReadEnvironment return an error naming the field instead. An inline
+// `env-default:"value"` tag provides a default without needing an entry in
+// defaultEnvData, and `env-description:"..."` documents the field for Usage.
+// Precedence for the value assigned is:
+//  1. Environment variable (first set name, see below)
+//  2. Inline env-default tag
+//  3. defaultEnvData, keyed on the first name in 'env'
+//  4. Zero value (error instead, if env-required is set)
+//
+// The 'env' tag may list more than one variable name, separated by commas
+// (e.g. `env:"APP_HOST,LEGACY_HOST,HOST"`); they are tried in order and the
+// first one that is set wins, which is handy when migrating a variable to a
+// new name or supporting a platform-specific alias. Note this reserves ','
+// inside the 'env' tag for separating names - []string fields split their
+// value on a separate `env-separator` tag instead (default ",").
 //
 // Supported field types:
 //   - string: Direct assignment from environment variable
 //   - bool: Parsed using strconv.ParseBool
-//   - int, int8, int16, int32, int64: Parsed as base-10 integers
-//   - []string: Comma-separated values split into a slice
+//   - int, int8, int16, int32, int64: Parsed using strconv.ParseInt
+//   - uint, uint8, uint16, uint32, uint64: Parsed using strconv.ParseUint
+//   - float32, float64: Parsed using strconv.ParseFloat
+//   - time.Duration: Parsed using time.ParseDuration (e.g. "5s", "1h30m")
+//   - time.Time: Parsed using time.Parse with an `env-layout` tag
+//     (default time.RFC3339)
+//   - *time.Location: Parsed using time.LoadLocation
+//   - []T for any scalar type above: Split on an `env-separator` tag
+//     (default ",") and each element parsed individually
+//   - map[K]V for scalar K and V: Entries split on `env-separator`
+//     (default ",") and each "key:value" pair split on `env-kv-separator`
+//     (default ":")
+//   - struct: Recursed into, see "Nested structs" below
+//   - anything implementing Setter: handled entirely by its SetValue method
+//
+// Parse failures return an error wrapping one of ErrInvalidBool,
+// ErrInvalidInt, ErrInvalidUint, ErrInvalidFloat, ErrInvalidDuration,
+// ErrInvalidTime, ErrInvalidLocation or ErrUnsupportedType, so callers can
+// errors.Is against the offending kind.
 //
-// The function follows this priority for value assignment:
-//  1. Environment variable value (if set and non-empty)
-//  2. Default value from defaultEnvData map (if environment variable is empty)
+// Nested structs:
+//
+// A struct-typed field is not itself read from the environment; instead its
+// fields are visited recursively. A dedicated `env-prefix` tag on the field
+// is prepended to every 'env' tag found inside it (and to any further nested
+// `env-prefix`), which makes it possible to namespace sub-sections of a
+// config (DB, HTTP, Logger, ...) under a common variable prefix:
+//
+//	type Config struct {
+//	    DB DBConfig `env-prefix:"DB_"`
+//	}
+//
+//	type DBConfig struct {
+//	    Host string `env:"HOST"` // read from DB_HOST
+//	}
+//
+// Anonymous (embedded) struct fields are recursed into the same way, and
+// since they are typically used for composition rather than namespacing,
+// omitting `env-prefix` on them is the common case.
 //
 // Example:
 //
@@ -64,10 +188,13 @@ var (
 // Returns:
 //   - ErrNilPointerDeference if v is nil
 //   - ErrVMustBePtr if v is not a pointer to a struct
-//   - An error if any field is missing an 'env' tag
-//   - An error if any environment variable is not set and has no default
+//   - An error if any leaf field is missing an 'env' tag
+//   - An error wrapping ErrFieldRequired if an env-required field has no value
 //   - An error if type conversion fails for bool or integer fields
 //   - An error if a field type is unsupported
+//
+// All field-level errors report the full dotted field path (e.g. "DB.Host") so
+// that failures in deeply nested configs can be traced back to their source.
 func ReadEnvironment(v any, defaultEnvData map[string]string) error {
 	if v == nil {
 		return ErrNilPointerDeference
@@ -89,55 +216,412 @@ func ReadEnvironment(v any, defaultEnvData map[string]string) error {
 		return ErrVMustBePtr
 	}
 
-	refType := reflect.TypeOf(refVal.Interface())
+	return readStruct(refVal, "", "", defaultEnvData, nil)
+}
+
+// ReadEnvironmentAll behaves like ReadEnvironment, except it does not stop
+// at the first problem field: every field is visited, and every failure is
+// collected instead of aborting the walk. The returned error joins one
+// *FieldError per failing field via errors.Join, so errors.Is, errors.As and
+// Unwrap() []error all work against it. This is useful when bootstrapping
+// an app with many possible misconfigurations, to report all of them in one
+// pass rather than fixing them one at a time across repeated runs.
+func ReadEnvironmentAll(v any, defaultEnvData map[string]string) error {
+	if v == nil {
+		return ErrNilPointerDeference
+	}
+
+	refVal := reflect.ValueOf(v)
 
+	if refVal.Kind() == reflect.Ptr {
+		refVal = reflect.Indirect(refVal)
+	} else {
+		return ErrVMustBePtr
+	}
+
+	if refVal.Kind() == reflect.Interface {
+		refVal = refVal.Elem()
+	}
+
+	if refVal.Kind() != reflect.Struct {
+		return ErrVMustBePtr
+	}
+
+	var errs []error
+	_ = readStruct(refVal, "", "", defaultEnvData, &errs)
+	return errors.Join(errs...)
+}
+
+// recordFieldError reports a field-level failure. In fail-fast mode
+// (errs == nil) it reports that the caller should abort by returning true,
+// leaving err untouched so ReadEnvironment's error values and messages are
+// unaffected by this plumbing. In collect mode it wraps err in a *FieldError
+// and appends it to *errs, reporting that the caller should continue to the
+// next field.
+func recordFieldError(errs *[]error, field, envName string, err error) (shouldReturn bool) {
+	if errs == nil {
+		return true
+	}
+	*errs = append(*errs, &FieldError{Field: field, EnvName: envName, Err: err})
+	return false
+}
+
+// lookupEnvValue resolves a field's value with the following precedence:
+//  1. The first set value among envNames, in process environment order
+//  2. The inline `env-default` tag value, if present
+//  3. defaultEnvData keyed on envNames[0]
+//
+// The second return value reports whether a value was found by any of the
+// above; false means the field should keep its zero value (or error, if the
+// field is required - see isRequired).
+func lookupEnvValue(envNames []string, fieldType reflect.StructField, defaultEnvData map[string]string) (string, bool) {
+	for _, envName := range envNames {
+		if value := os.Getenv(envName); value != "" {
+			return value, true
+		}
+	}
+
+	if inlineDefault, ok := fieldType.Tag.Lookup("env-default"); ok {
+		return inlineDefault, true
+	}
+
+	if len(envNames) == 0 {
+		return "", false
+	}
+
+	value := defaultEnvData[envNames[0]]
+	return value, value != ""
+}
+
+// isRequired reports whether fieldType carries `env-required:"true"`.
+func isRequired(fieldType reflect.StructField) bool {
+	required, _ := strconv.ParseBool(fieldType.Tag.Get("env-required"))
+	return required
+}
+
+// parseEnvNames splits an 'env' tag into its comma-separated list of
+// environment variable names (e.g. `env:"APP_HOST,LEGACY_HOST,HOST"`), the
+// first of which to be set wins, and prepends envPrefix to each.
+func parseEnvNames(envTag, envPrefix string) []string {
+	rawNames := strings.Split(envTag, ",")
+	envNames := make([]string, 0, len(rawNames))
+	for _, rawName := range rawNames {
+		rawName = strings.TrimSpace(rawName)
+		if rawName == "" {
+			continue
+		}
+		envNames = append(envNames, envPrefix+rawName)
+	}
+	return envNames
+}
+
+// parseValue parses raw into a value of targetType. It is used both for a
+// leaf field's own value and, for []T and map[K]V fields, for each element.
+//
+// time.Duration, time.Time and *time.Location are recognized by exact type
+// ahead of the general reflect.Kind switch, since their Kind (Int64, Struct,
+// Ptr) would otherwise be parsed as a plain number or recursed into. tag is
+// the struct field's tag, consulted for `env-layout` when targetType is
+// time.Time.
+func parseValue(targetType reflect.Type, raw string, tag reflect.StructTag) (reflect.Value, error) {
+	switch targetType {
+	case timeDurationType:
+		durationVal, err := time.ParseDuration(raw)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %w", ErrInvalidDuration, err)
+		}
+		return reflect.ValueOf(durationVal), nil
+	case timeTimeType:
+		layout := tag.Get("env-layout")
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		timeVal, err := time.Parse(layout, raw)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %w", ErrInvalidTime, err)
+		}
+		return reflect.ValueOf(timeVal), nil
+	case timeLocationType:
+		locVal, err := time.LoadLocation(raw)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %w", ErrInvalidLocation, err)
+		}
+		return reflect.ValueOf(locVal), nil
+	}
+
+	switch targetType.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(raw).Convert(targetType), nil
+	case reflect.Bool:
+		boolVal, err := strconv.ParseBool(raw)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %w", ErrInvalidBool, err)
+		}
+		return reflect.ValueOf(boolVal).Convert(targetType), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		intVal, err := strconv.ParseInt(raw, 10, targetType.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %w", ErrInvalidInt, err)
+		}
+		result := reflect.New(targetType).Elem()
+		result.SetInt(intVal)
+		return result, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		uintVal, err := strconv.ParseUint(raw, 10, targetType.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %w", ErrInvalidUint, err)
+		}
+		result := reflect.New(targetType).Elem()
+		result.SetUint(uintVal)
+		return result, nil
+	case reflect.Float32, reflect.Float64:
+		floatVal, err := strconv.ParseFloat(raw, targetType.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %w", ErrInvalidFloat, err)
+		}
+		result := reflect.New(targetType).Elem()
+		result.SetFloat(floatVal)
+		return result, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("%w: %s", ErrUnsupportedType, targetType.Kind())
+	}
+}
+
+// readStruct populates the fields of refVal, recursing into nested structs.
+//
+// envPrefix is prepended to every 'env' tag resolved at this level, and path
+// is the dotted field path accumulated so far, used only to make error
+// messages trace back to their source field. errs controls fail-fast vs
+// collect-all-errors mode: nil aborts and returns the first error, as
+// ReadEnvironment expects; a non-nil pointer instead appends every failing
+// field's error to *errs and keeps going, as ReadEnvironmentAll expects -
+// see recordFieldError.
+func readStruct(refVal reflect.Value, envPrefix, path string, defaultEnvData map[string]string, errs *[]error) error {
+	refType := refVal.Type()
+
+fields:
 	for i := range refVal.NumField() {
 		fieldVal := refVal.Field(i)
 		fieldType := refType.Field(i)
 
-		envTag := fieldType.Tag.Get("env")
-		if envTag == "" {
-			return fmt.Errorf("Struct field \"%s\" is missing 'env' tag", fieldType.Name)
+		fieldPath := fieldType.Name
+		if path != "" {
+			fieldPath = path + "." + fieldType.Name
 		}
 
-		valueToAssign := os.Getenv(envTag)
-		if valueToAssign == "" {
-			valueToAssign = defaultEnvData[envTag]
+		if !fieldVal.IsValid() || !fieldVal.CanAddr() || !fieldVal.CanSet() {
+			err := fmt.Errorf("cannot assign to field %s", fieldPath)
+			if recordFieldError(errs, fieldPath, "", err) {
+				return err
+			}
+			continue
 		}
 
-		if valueToAssign == "" {
-			return fmt.Errorf("environment variable %s not set", envTag)
+		setter, isSetter := fieldVal.Addr().Interface().(Setter)
+
+		if fieldVal.Kind() == reflect.Struct && !isSetter && fieldVal.Type() != timeTimeType {
+			childPrefix := envPrefix + fieldType.Tag.Get("env-prefix")
+			if err := readStruct(fieldVal, childPrefix, fieldPath, defaultEnvData, errs); err != nil {
+				return err
+			}
+			continue
 		}
 
-		if !fieldVal.IsValid() || !fieldVal.CanAddr() || !fieldVal.CanSet() {
-			return fmt.Errorf("cannot assign to field %s", fieldType.Name)
+		envTag := fieldType.Tag.Get("env")
+		if envTag == "" {
+			err := fmt.Errorf("Struct field \"%s\" is missing 'env' tag", fieldPath)
+			if recordFieldError(errs, fieldPath, "", err) {
+				return err
+			}
+			continue
 		}
+		envNames := parseEnvNames(envTag, envPrefix)
+		joinedEnvNames := strings.Join(envNames, " or ")
 
-		switch fieldVal.Kind() {
-		case reflect.String:
-			fieldVal.SetString(valueToAssign)
-		case reflect.Bool:
-			boolVal, err := strconv.ParseBool(valueToAssign)
-			if err != nil {
-				return fmt.Errorf("failed to parse bool for field %s: %w", fieldType.Name, err)
+		valueToAssign, ok := lookupEnvValue(envNames, fieldType, defaultEnvData)
+		if !ok {
+			if isRequired(fieldType) {
+				err := fmt.Errorf("%w: environment variable %s not set for field %s", ErrFieldRequired, joinedEnvNames, fieldPath)
+				if recordFieldError(errs, fieldPath, joinedEnvNames, err) {
+					return err
+				}
 			}
-			fieldVal.SetBool(boolVal)
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			intVal, err := strconv.ParseInt(valueToAssign, 10, 64)
-			if err != nil {
-				return fmt.Errorf("failed to parse int for field %s: %w", fieldType.Name, err)
+			continue
+		}
+
+		if isSetter {
+			if err := setter.SetValue(valueToAssign); err != nil {
+				wrapped := fmt.Errorf("failed to set value for field %s: %w", fieldPath, err)
+				if recordFieldError(errs, fieldPath, joinedEnvNames, wrapped) {
+					return wrapped
+				}
 			}
-			fieldVal.SetInt(intVal)
+			continue
+		}
+
+		switch fieldVal.Kind() {
 		case reflect.Array, reflect.Slice:
-			// Currently only supports []string
-			if fieldVal.Type().Elem().Kind() != reflect.String {
-				return fmt.Errorf("unsupported slice element type %s for field %s", fieldVal.Type().Elem().Kind().String(), fieldType.Name)
+			elemType := fieldVal.Type().Elem()
+			separator := fieldType.Tag.Get("env-separator")
+			if separator == "" {
+				separator = ","
+			}
+			rawItems := strings.Split(valueToAssign, separator)
+			sliceVal := reflect.MakeSlice(fieldVal.Type(), len(rawItems), len(rawItems))
+			for idx, rawItem := range rawItems {
+				itemVal, err := parseValue(elemType, strings.TrimSpace(rawItem), fieldType.Tag)
+				if err != nil {
+					wrapped := fmt.Errorf("failed to parse element %d for field %s: %w", idx, fieldPath, err)
+					if recordFieldError(errs, fieldPath, joinedEnvNames, wrapped) {
+						return wrapped
+					}
+					continue fields
+				}
+				sliceVal.Index(idx).Set(itemVal)
 			}
-			strArr := strings.Split(valueToAssign, ",")
-			fieldVal.Set(reflect.ValueOf(strArr))
+			fieldVal.Set(sliceVal)
+		case reflect.Map:
+			mapType := fieldVal.Type()
+			entrySeparator := fieldType.Tag.Get("env-separator")
+			if entrySeparator == "" {
+				entrySeparator = ","
+			}
+			kvSeparator := fieldType.Tag.Get("env-kv-separator")
+			if kvSeparator == "" {
+				kvSeparator = ":"
+			}
+			mapVal := reflect.MakeMap(mapType)
+			for _, rawEntry := range strings.Split(valueToAssign, entrySeparator) {
+				rawEntry = strings.TrimSpace(rawEntry)
+				if rawEntry == "" {
+					continue
+				}
+				rawKV := strings.SplitN(rawEntry, kvSeparator, 2)
+				if len(rawKV) != 2 {
+					err := fmt.Errorf("invalid map entry %q for field %s: expected \"key%svalue\"", rawEntry, fieldPath, kvSeparator)
+					if recordFieldError(errs, fieldPath, joinedEnvNames, err) {
+						return err
+					}
+					continue fields
+				}
+				keyVal, err := parseValue(mapType.Key(), strings.TrimSpace(rawKV[0]), fieldType.Tag)
+				if err != nil {
+					wrapped := fmt.Errorf("failed to parse map key %q for field %s: %w", rawKV[0], fieldPath, err)
+					if recordFieldError(errs, fieldPath, joinedEnvNames, wrapped) {
+						return wrapped
+					}
+					continue fields
+				}
+				elemVal, err := parseValue(mapType.Elem(), strings.TrimSpace(rawKV[1]), fieldType.Tag)
+				if err != nil {
+					wrapped := fmt.Errorf("failed to parse map value %q for field %s: %w", rawKV[1], fieldPath, err)
+					if recordFieldError(errs, fieldPath, joinedEnvNames, wrapped) {
+						return wrapped
+					}
+					continue fields
+				}
+				mapVal.SetMapIndex(keyVal, elemVal)
+			}
+			fieldVal.Set(mapVal)
 		default:
-			return fmt.Errorf("unsupported field type %s for field %s", fieldVal.Kind().String(), fieldType.Name)
+			parsedVal, err := parseValue(fieldVal.Type(), valueToAssign, fieldType.Tag)
+			if err != nil {
+				wrapped := fmt.Errorf("failed to parse value for field %s: %w", fieldPath, err)
+				if recordFieldError(errs, fieldPath, joinedEnvNames, wrapped) {
+					return wrapped
+				}
+				continue
+			}
+			fieldVal.Set(parsedVal)
+		}
+	}
+
+	return nil
+}
+
+// Usage walks v the same way ReadEnvironment does and writes an aligned
+// table of environment variable name, type, default value, required flag,
+// and description to w, one row per leaf field. It lets an application
+// implement `--help` output directly from its config struct, e.g.:
+//
+//	if *help {
+//	    envscan.Usage(&cfg, os.Stdout)
+//	    os.Exit(0)
+//	}
+func Usage(v any, w io.Writer) error {
+	if v == nil {
+		return ErrNilPointerDeference
+	}
+
+	refVal := reflect.ValueOf(v)
+
+	if refVal.Kind() == reflect.Ptr {
+		refVal = reflect.Indirect(refVal)
+	} else {
+		return ErrVMustBePtr
+	}
+
+	if refVal.Kind() == reflect.Interface {
+		refVal = refVal.Elem()
+	}
+
+	if refVal.Kind() != reflect.Struct {
+		return ErrVMustBePtr
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ENV\tTYPE\tDEFAULT\tREQUIRED\tDESCRIPTION")
+
+	if err := writeUsage(refVal, "", "", tw); err != nil {
+		return err
+	}
+
+	return tw.Flush()
+}
+
+// writeUsage is Usage's recursive field walker, mirroring readStruct's
+// traversal (nested structs, env-prefix, multi-name env tags) without
+// assigning any values.
+func writeUsage(refVal reflect.Value, envPrefix, path string, tw *tabwriter.Writer) error {
+	refType := refVal.Type()
+
+	for i := range refVal.NumField() {
+		fieldVal := refVal.Field(i)
+		fieldType := refType.Field(i)
+
+		fieldPath := fieldType.Name
+		if path != "" {
+			fieldPath = path + "." + fieldType.Name
+		}
+
+		if !fieldVal.IsValid() || !fieldVal.CanAddr() || !fieldVal.CanSet() {
+			return fmt.Errorf("cannot assign to field %s", fieldPath)
+		}
+
+		_, isSetter := fieldVal.Addr().Interface().(Setter)
+
+		if fieldVal.Kind() == reflect.Struct && !isSetter && fieldVal.Type() != timeTimeType {
+			childPrefix := envPrefix + fieldType.Tag.Get("env-prefix")
+			if err := writeUsage(fieldVal, childPrefix, fieldPath, tw); err != nil {
+				return err
+			}
+			continue
 		}
+
+		envTag := fieldType.Tag.Get("env")
+		if envTag == "" {
+			return fmt.Errorf("Struct field \"%s\" is missing 'env' tag", fieldPath)
+		}
+		envNames := parseEnvNames(envTag, envPrefix)
+
+		fmt.Fprintf(
+			tw, "%s\t%s\t%s\t%t\t%s\n",
+			strings.Join(envNames, " or "),
+			fieldVal.Type().String(),
+			fieldType.Tag.Get("env-default"),
+			isRequired(fieldType),
+			fieldType.Tag.Get("env-description"),
+		)
 	}
 
 	return nil
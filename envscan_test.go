@@ -1,8 +1,12 @@
 package envscan
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestReadEnvironmentSuccess(t *testing.T) {
@@ -97,23 +101,582 @@ func TestReadEnvironmentStructErrTagMissing(t *testing.T) {
 	}
 }
 
+func TestReadEnvironmentNestedStructWithPrefix(t *testing.T) {
+	type DBConfig struct {
+		Host string `env:"HOST"`
+		Port int    `env:"PORT"`
+	}
+
+	type MockConfig struct {
+		Name string   `env:"APP_NAME"`
+		DB   DBConfig `env-prefix:"DB_"`
+	}
+
+	t.Setenv("APP_NAME", "envscan")
+	t.Setenv("DB_HOST", "localhost")
+	t.Setenv("DB_PORT", "5432")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Name != "envscan" {
+		t.Errorf("expected: envscan, got: %s", scanningObj.Name)
+	}
+
+	if scanningObj.DB.Host != "localhost" {
+		t.Errorf("expected: localhost, got: %s", scanningObj.DB.Host)
+	}
+
+	if scanningObj.DB.Port != 5432 {
+		t.Errorf("expected: 5432, got: %d", scanningObj.DB.Port)
+	}
+}
+
+func TestReadEnvironmentEmbeddedStructNoPrefix(t *testing.T) {
+	type Embedded struct {
+		Host string `env:"HOST"`
+	}
+
+	type MockConfig struct {
+		Embedded
+		Name string `env:"APP_NAME"`
+	}
+
+	t.Setenv("HOST", "localhost")
+	t.Setenv("APP_NAME", "envscan")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Host != "localhost" {
+		t.Errorf("expected: localhost, got: %s", scanningObj.Host)
+	}
+}
+
+func TestReadEnvironmentNestedStructErrIncludesPath(t *testing.T) {
+	type DBConfig struct {
+		Host string `env:"HOST" env-required:"true"`
+	}
+
+	type MockConfig struct {
+		DB DBConfig `env-prefix:"DB_"`
+	}
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if !errors.Is(err, ErrFieldRequired) {
+		t.Errorf("expected error to wrap ErrFieldRequired, got: %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "DB_HOST") || !strings.Contains(err.Error(), "DB.Host") {
+		t.Errorf("expected error to mention DB_HOST and DB.Host, got: %q", err.Error())
+	}
+}
+
+type mockLevel int
+
+const (
+	mockLevelUnknown mockLevel = iota
+	mockLevelDebug
+	mockLevelInfo
+)
+
+func (l *mockLevel) SetValue(raw string) error {
+	switch raw {
+	case "debug":
+		*l = mockLevelDebug
+	case "info":
+		*l = mockLevelInfo
+	default:
+		return fmt.Errorf("unknown level %q", raw)
+	}
+	return nil
+}
+
+func TestReadEnvironmentSetterPointerReceiver(t *testing.T) {
+	type MockConfig struct {
+		Level mockLevel `env:"MOCK_LEVEL"`
+	}
+
+	t.Setenv("MOCK_LEVEL", "info")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Level != mockLevelInfo {
+		t.Errorf("expected: %d, got: %d", mockLevelInfo, scanningObj.Level)
+	}
+}
+
+func TestReadEnvironmentSetterErrIsWrapped(t *testing.T) {
+	type MockConfig struct {
+		Level mockLevel `env:"MOCK_LEVEL"`
+	}
+
+	t.Setenv("MOCK_LEVEL", "trace")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "unknown level") {
+		t.Errorf("expected error to wrap SetValue's error, got: %v", err)
+	}
+}
+
+func TestReadEnvironmentMultipleNamesFirstSetWins(t *testing.T) {
+	type MockConfig struct {
+		Host string `env:"APP_HOST,LEGACY_HOST,HOST"`
+	}
+
+	t.Setenv("LEGACY_HOST", "legacy.local")
+	t.Setenv("HOST", "fallback.local")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Host != "legacy.local" {
+		t.Errorf("expected: legacy.local, got: %s", scanningObj.Host)
+	}
+}
+
+func TestReadEnvironmentMultipleNamesFallsBackToDefaultOnFirstName(t *testing.T) {
+	type MockConfig struct {
+		Host string `env:"APP_HOST,LEGACY_HOST"`
+	}
+
+	defaultEnvData := map[string]string{
+		"APP_HOST": "default.local",
+	}
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, defaultEnvData)
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Host != "default.local" {
+		t.Errorf("expected: default.local, got: %s", scanningObj.Host)
+	}
+}
+
+func TestReadEnvironmentSliceCustomSeparator(t *testing.T) {
+	type MockConfig struct {
+		Features []string `env:"MOCK_FEATURES" env-separator:";"`
+	}
+
+	t.Setenv("MOCK_FEATURES", "a;b;c")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	expected := []string{"a", "b", "c"}
+	if len(scanningObj.Features) != len(expected) {
+		t.Fatalf("expected len %d, got %d", len(expected), len(scanningObj.Features))
+	}
+
+	for i := range expected {
+		if scanningObj.Features[i] != expected[i] {
+			t.Errorf("expected elem %s, got %s", expected[i], scanningObj.Features[i])
+		}
+	}
+}
+
+func TestReadEnvironmentRicherScalarTypes(t *testing.T) {
+	type MockConfig struct {
+		MaxConns uint           `env:"MOCK_MAX_CONNS"`
+		Ratio    float64        `env:"MOCK_RATIO"`
+		Timeout  time.Duration  `env:"MOCK_TIMEOUT"`
+		StartAt  time.Time      `env:"MOCK_START_AT"`
+		TZ       *time.Location `env:"MOCK_TZ"`
+	}
+
+	t.Setenv("MOCK_MAX_CONNS", "10")
+	t.Setenv("MOCK_RATIO", "0.75")
+	t.Setenv("MOCK_TIMEOUT", "5s")
+	t.Setenv("MOCK_START_AT", "2024-01-02T15:04:05Z")
+	t.Setenv("MOCK_TZ", "UTC")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.MaxConns != 10 {
+		t.Errorf("expected: 10, got: %d", scanningObj.MaxConns)
+	}
+
+	if scanningObj.Ratio != 0.75 {
+		t.Errorf("expected: 0.75, got: %f", scanningObj.Ratio)
+	}
+
+	if scanningObj.Timeout != 5*time.Second {
+		t.Errorf("expected: 5s, got: %s", scanningObj.Timeout)
+	}
+
+	expectedStart, _ := time.Parse(time.RFC3339, "2024-01-02T15:04:05Z")
+	if !scanningObj.StartAt.Equal(expectedStart) {
+		t.Errorf("expected: %s, got: %s", expectedStart, scanningObj.StartAt)
+	}
+
+	if scanningObj.TZ.String() != "UTC" {
+		t.Errorf("expected: UTC, got: %s", scanningObj.TZ.String())
+	}
+}
+
+func TestReadEnvironmentTimeLayoutTag(t *testing.T) {
+	type MockConfig struct {
+		StartAt time.Time `env:"MOCK_START_AT" env-layout:"2006-01-02"`
+	}
+
+	t.Setenv("MOCK_START_AT", "2024-01-02")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	expected, _ := time.Parse("2006-01-02", "2024-01-02")
+	if !scanningObj.StartAt.Equal(expected) {
+		t.Errorf("expected: %s, got: %s", expected, scanningObj.StartAt)
+	}
+}
+
+func TestReadEnvironmentSliceOfInts(t *testing.T) {
+	type MockConfig struct {
+		Ports []int `env:"MOCK_PORTS"`
+	}
+
+	t.Setenv("MOCK_PORTS", "80,443,8080")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	expected := []int{80, 443, 8080}
+	if len(scanningObj.Ports) != len(expected) {
+		t.Fatalf("expected len %d, got %d", len(expected), len(scanningObj.Ports))
+	}
+
+	for i, port := range expected {
+		if scanningObj.Ports[i] != port {
+			t.Errorf("expected elem %d, got %d", port, scanningObj.Ports[i])
+		}
+	}
+}
+
+func TestReadEnvironmentMapStringString(t *testing.T) {
+	type MockConfig struct {
+		Labels map[string]string `env:"MOCK_LABELS"`
+	}
+
+	t.Setenv("MOCK_LABELS", "team:core,env:prod")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Labels["team"] != "core" || scanningObj.Labels["env"] != "prod" {
+		t.Errorf("expected team:core,env:prod, got: %v", scanningObj.Labels)
+	}
+}
+
+func TestReadEnvironmentMapStringIntCustomSeparators(t *testing.T) {
+	type MockConfig struct {
+		Weights map[string]int `env:"MOCK_WEIGHTS" env-separator:";" env-kv-separator:"="`
+	}
+
+	t.Setenv("MOCK_WEIGHTS", "a=1;b=2")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Weights["a"] != 1 || scanningObj.Weights["b"] != 2 {
+		t.Errorf("expected a=1;b=2, got: %v", scanningObj.Weights)
+	}
+}
+
+func TestReadEnvironmentInvalidIntWrapsErrInvalidInt(t *testing.T) {
+	type MockConfig struct {
+		Port int `env:"MOCK_PORT"`
+	}
+
+	t.Setenv("MOCK_PORT", "not-a-number")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if !errors.Is(err, ErrInvalidInt) {
+		t.Errorf("expected error to wrap ErrInvalidInt, got: %v", err)
+	}
+}
+
+func TestReadEnvironmentAllCollectsEveryFieldError(t *testing.T) {
+	type MockConfig struct {
+		Host string `env:"MOCK_HOST" env-required:"true"`
+		Port int    `env:"MOCK_PORT"`
+	}
+
+	t.Setenv("MOCK_PORT", "not-a-number")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironmentAll(scanningObj, make(map[string]string))
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) {
+		t.Fatalf("expected error to implement Unwrap() []error, got: %v", err)
+	}
+
+	if len(joined.Unwrap()) != 2 {
+		t.Fatalf("expected 2 joined errors, got %d: %v", len(joined.Unwrap()), joined.Unwrap())
+	}
+
+	if !errors.Is(err, ErrFieldRequired) {
+		t.Errorf("expected error to wrap ErrFieldRequired, got: %v", err)
+	}
+
+	if !errors.Is(err, ErrInvalidInt) {
+		t.Errorf("expected error to wrap ErrInvalidInt, got: %v", err)
+	}
+
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("expected error to contain a *FieldError, got: %v", err)
+	}
+}
+
+func TestReadEnvironmentAllSucceedsWhenAllFieldsValid(t *testing.T) {
+	type MockConfig struct {
+		Host string `env:"MOCK_HOST"`
+		Port int    `env:"MOCK_PORT"`
+	}
+
+	t.Setenv("MOCK_HOST", "localhost")
+	t.Setenv("MOCK_PORT", "8080")
+
+	scanningObj := &MockConfig{}
+
+	if err := ReadEnvironmentAll(scanningObj, make(map[string]string)); err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Host != "localhost" || scanningObj.Port != 8080 {
+		t.Errorf("expected localhost/8080, got: %s/%d", scanningObj.Host, scanningObj.Port)
+	}
+}
+
 func TestReadEnvironmentErrVarNotSet(t *testing.T) {
 	type MockConfig struct {
-		MockString      string   `env:"MOCK_STRING"`
+		MockString      string   `env:"MOCK_STRING" env-required:"true"`
 		MockSliceString []string `env:"MOCK_SLICE_STRING"`
 	}
 
 	t.Setenv("MOCK_SLICE_STRING", "some_value,some_other_value")
 
 	scanningWrongObj := &MockConfig{}
-	expectedErr := errors.New("environment variable MOCK_STRING not set")
 
 	err := ReadEnvironment(scanningWrongObj, make(map[string]string))
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
 
-	if err.Error() != expectedErr.Error() {
-		t.Errorf("expected %q, got %q", expectedErr.Error(), err.Error())
+	if !errors.Is(err, ErrFieldRequired) {
+		t.Errorf("expected error to wrap ErrFieldRequired, got: %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "MOCK_STRING") {
+		t.Errorf("expected error to mention MOCK_STRING, got: %q", err.Error())
+	}
+}
+
+func TestReadEnvironmentOptionalFieldDefaultsToZeroValue(t *testing.T) {
+	type MockConfig struct {
+		Port int `env:"MOCK_PORT"`
+	}
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Port != 0 {
+		t.Errorf("expected: 0, got: %d", scanningObj.Port)
+	}
+}
+
+func TestReadEnvironmentInlineDefaultWinsOverDefaultsMap(t *testing.T) {
+	type MockConfig struct {
+		Port int `env:"MOCK_PORT" env-default:"8080"`
+	}
+
+	defaultEnvData := map[string]string{
+		"MOCK_PORT": "9090",
+	}
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, defaultEnvData)
+	if err != nil {
+		t.Fatalf("failed to scan env, err: %v", err)
+	}
+
+	if scanningObj.Port != 8080 {
+		t.Errorf("expected: 8080, got: %d", scanningObj.Port)
+	}
+}
+
+func TestUsageWritesAlignedTable(t *testing.T) {
+	type MockConfig struct {
+		Host string `env:"MOCK_HOST" env-required:"true" env-description:"server host"`
+		Port int    `env:"MOCK_PORT" env-default:"8080" env-description:"server port"`
+	}
+
+	var buf bytes.Buffer
+	if err := Usage(&MockConfig{}, &buf); err != nil {
+		t.Fatalf("failed to write usage, err: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"MOCK_HOST", "MOCK_PORT", "8080", "server host", "server port"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected usage output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestReadEnvironmentUintOverflowWrapsErrInvalidUint(t *testing.T) {
+	type MockConfig struct {
+		Code uint8 `env:"MOCK_CODE"`
+	}
+
+	t.Setenv("MOCK_CODE", "300")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if !errors.Is(err, ErrInvalidUint) {
+		t.Errorf("expected error to wrap ErrInvalidUint, got: %v", err)
+	}
+}
+
+func TestReadEnvironmentFloatOverflowWrapsErrInvalidFloat(t *testing.T) {
+	type MockConfig struct {
+		Ratio float32 `env:"MOCK_RATIO"`
+	}
+
+	t.Setenv("MOCK_RATIO", "1e300")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if !errors.Is(err, ErrInvalidFloat) {
+		t.Errorf("expected error to wrap ErrInvalidFloat, got: %v", err)
+	}
+}
+
+func TestReadEnvironmentIntOverflowWrapsErrInvalidInt(t *testing.T) {
+	type MockConfig struct {
+		Code int8 `env:"MOCK_CODE"`
+	}
+
+	t.Setenv("MOCK_CODE", "300")
+
+	scanningObj := &MockConfig{}
+
+	err := ReadEnvironment(scanningObj, make(map[string]string))
+	if !errors.Is(err, ErrInvalidInt) {
+		t.Errorf("expected error to wrap ErrInvalidInt, got: %v", err)
+	}
+}
+
+type mockStructSetter struct {
+	inner string
+}
+
+func (s *mockStructSetter) SetValue(raw string) error {
+	s.inner = raw
+	return nil
+}
+
+func TestUsageTreatsStructFieldsImplementingSetterAsLeaves(t *testing.T) {
+	type MockConfig struct {
+		Host  string           `env:"MOCK_HOST"`
+		Level mockStructSetter `env:"MOCK_LEVEL"`
+	}
+
+	var buf bytes.Buffer
+	if err := Usage(&MockConfig{}, &buf); err != nil {
+		t.Fatalf("failed to write usage, err: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "MOCK_LEVEL") {
+		t.Errorf("expected usage output to document MOCK_LEVEL as a leaf field, got:\n%s", out)
+	}
+}
+
+func TestUsageErrsInsteadOfPanickingOnUnexportedField(t *testing.T) {
+	type MockConfig struct {
+		Host     string `env:"MOCK_HOST"`
+		unlisted string
+	}
+
+	var buf bytes.Buffer
+	err := Usage(&MockConfig{}, &buf)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "unlisted") {
+		t.Errorf("expected error to mention the unexported field, got: %v", err)
 	}
 }